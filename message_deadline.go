@@ -0,0 +1,72 @@
+package openai
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDeadlineExceeded is returned when an operator-configured deadline (set
+// via WithDeadline or MessageStream.SetReadDeadline) elapses, as opposed to
+// the caller's own context.Context being cancelled or timing out. Retry
+// logic can use errors.Is against this to tell the two apart.
+var ErrDeadlineExceeded = errors.New("openai: operator-configured deadline exceeded")
+
+// MessageRequestOption configures a single CreateMessage, ListMessage or
+// CreateMessageStream call.
+type MessageRequestOption func(*messageRequestOptions)
+
+type messageRequestOptions struct {
+	deadline time.Time
+}
+
+// WithDeadline sets an absolute deadline for a single call that is
+// independent of ctx, borrowing the deadline-composition idea behind
+// netstack's deadlineTimer: the deadline is layered on top of whatever ctx
+// the caller already passed in, via context.WithDeadline, rather than
+// replacing it. Whichever of ctx's own deadline/cancellation or this one
+// elapses first wins; if this one does, the call returns
+// ErrDeadlineExceeded instead of context.DeadlineExceeded so callers can
+// tell operator-configured timeouts apart from upstream cancellation.
+func WithDeadline(t time.Time) MessageRequestOption {
+	return func(o *messageRequestOptions) {
+		o.deadline = t
+	}
+}
+
+func resolveMessageRequestOptions(opts []MessageRequestOption) messageRequestOptions {
+	var o messageRequestOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// withOperatorDeadline composes ctx with any WithDeadline option and
+// returns the derived context to dispatch the request with, a cancel func
+// the caller must invoke once the request completes, and a translate func
+// that turns a context.DeadlineExceeded caused by this operator deadline
+// (as opposed to ctx's own) into ErrDeadlineExceeded.
+func withOperatorDeadline(
+	ctx context.Context,
+	opts []MessageRequestOption,
+) (derived context.Context, cancel context.CancelFunc, translate func(error) error) {
+	o := resolveMessageRequestOptions(opts)
+	if o.deadline.IsZero() {
+		return ctx, func() {}, func(err error) error { return err }
+	}
+
+	parent := ctx
+	derived, cancel = context.WithDeadline(ctx, o.deadline)
+	translate = func(err error) error {
+		if err == nil || !errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		if parent.Err() == nil {
+			// parent is still live, so it was this operator deadline that fired.
+			return ErrDeadlineExceeded
+		}
+		return err
+	}
+	return derived, cancel, translate
+}
@@ -0,0 +1,83 @@
+package openai
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithOperatorDeadlineNoDeadline(t *testing.T) {
+	parent := context.Background()
+
+	derived, cancel, translate := withOperatorDeadline(parent, nil)
+	defer cancel()
+
+	if derived != parent {
+		t.Fatalf("derived ctx = %v, want the parent unchanged when no WithDeadline option is set", derived)
+	}
+
+	wantErr := errors.New("boom")
+	if got := translate(wantErr); got != wantErr {
+		t.Fatalf("translate(%v) = %v, want it passed through unchanged", wantErr, got)
+	}
+}
+
+func TestWithOperatorDeadlineTranslatesOperatorExpiry(t *testing.T) {
+	parent := context.Background()
+
+	derived, cancel, translate := withOperatorDeadline(parent, []MessageRequestOption{WithDeadline(time.Now().Add(-time.Second))})
+	defer cancel()
+
+	<-derived.Done()
+	if !errors.Is(derived.Err(), context.DeadlineExceeded) {
+		t.Fatalf("derived.Err() = %v, want context.DeadlineExceeded", derived.Err())
+	}
+
+	// parent is still live, so this DeadlineExceeded came from the
+	// operator-configured deadline, not the caller's own ctx.
+	if got := translate(derived.Err()); !errors.Is(got, ErrDeadlineExceeded) {
+		t.Fatalf("translate(%v) = %v, want ErrDeadlineExceeded", derived.Err(), got)
+	}
+}
+
+func TestWithOperatorDeadlineLeavesCallerExpiryAlone(t *testing.T) {
+	parent, parentCancel := context.WithTimeout(context.Background(), -time.Second)
+	defer parentCancel()
+
+	derived, cancel, translate := withOperatorDeadline(parent, []MessageRequestOption{WithDeadline(time.Now().Add(time.Hour))})
+	defer cancel()
+
+	// The caller's own ctx already expired, so the resulting
+	// DeadlineExceeded must NOT be rewritten to ErrDeadlineExceeded.
+	got := translate(context.DeadlineExceeded)
+	if !errors.Is(got, context.DeadlineExceeded) || errors.Is(got, ErrDeadlineExceeded) {
+		t.Fatalf("translate(context.DeadlineExceeded) = %v, want the original context.DeadlineExceeded preserved", got)
+	}
+	_ = derived
+}
+
+func TestMessageStreamDeadlineErrUsesStoredTranslate(t *testing.T) {
+	stream := newTestMessageStream("")
+	wantErr := errors.New("operator deadline fired upstream")
+	stream.translate = func(err error) error {
+		if err == context.DeadlineExceeded {
+			return wantErr
+		}
+		return err
+	}
+
+	if got := stream.deadlineErr(context.DeadlineExceeded); got != wantErr {
+		t.Fatalf("deadlineErr = %v, want the stream's translate func to run", got)
+	}
+}
+
+func TestMessageStreamSetReadDeadlineInThePastAbortsRecv(t *testing.T) {
+	stream := newTestMessageStream("event: thread.message.created\ndata: {}\n")
+	stream.SetReadDeadline(time.Now().Add(-time.Second))
+
+	_, err := stream.Recv()
+	if !errors.Is(err, ErrDeadlineExceeded) {
+		t.Fatalf("Recv() err = %v, want ErrDeadlineExceeded once a past SetReadDeadline has fired", err)
+	}
+}
@@ -0,0 +1,75 @@
+package openai
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// unwrapJSONError unwraps the *json.MarshalerError json.Marshal wraps a
+// MarshalJSON error in, so callers can compare it against the sentinel
+// MarshalJSON actually returned.
+func unwrapJSONError(err error) error {
+	var marshalerErr *json.MarshalerError
+	if errors.As(err, &marshalerErr) {
+		return marshalerErr.Unwrap()
+	}
+	return err
+}
+
+func TestMessageRequestMarshalJSON(t *testing.T) {
+	t.Run("plain content", func(t *testing.T) {
+		body, err := json.Marshal(MessageRequest{Role: "user", Content: "hello"})
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+
+		var decoded map[string]any
+		if err := json.Unmarshal(body, &decoded); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+		if decoded["content"] != "hello" {
+			t.Fatalf("content = %v, want %q", decoded["content"], "hello")
+		}
+		if _, ok := decoded["file_ids"]; ok {
+			t.Fatalf("got file_ids in output, want it omitted when empty")
+		}
+	})
+
+	t.Run("multi content", func(t *testing.T) {
+		req := MessageRequest{
+			Role: "user",
+			MultiContent: []MessageContentPart{
+				{Type: MessageContentTypeText, Text: "hello"},
+				{Type: MessageContentTypeImageURL, ImageURL: &ImageURL{URL: "https://example.com/cat.png"}},
+			},
+		}
+		body, err := json.Marshal(req)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+
+		var decoded map[string]any
+		if err := json.Unmarshal(body, &decoded); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+		content, ok := decoded["content"].([]any)
+		if !ok || len(content) != 2 {
+			t.Fatalf("content = %v, want a 2-element array", decoded["content"])
+		}
+		if _, ok := decoded["text"]; ok {
+			t.Fatalf("got top-level text field, want text parts kept inside the content array")
+		}
+	})
+
+	t.Run("both content fields set is an error", func(t *testing.T) {
+		req := MessageRequest{
+			Role:         "user",
+			Content:      "hello",
+			MultiContent: []MessageContentPart{{Type: MessageContentTypeText, Text: "hello"}},
+		}
+		if _, err := json.Marshal(req); !errors.Is(unwrapJSONError(err), ErrMessageContentFieldsMisused) {
+			t.Fatalf("Marshal err = %v, want ErrMessageContentFieldsMisused", err)
+		}
+	})
+}
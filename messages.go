@@ -2,6 +2,8 @@ package openai
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -30,11 +32,15 @@ const (
 	MessageToolTypeFileSearch      MessageToolType = "file_search"
 )
 
+// AttachmentTool is a tool enabled on a file Attachment, e.g.
+// {"type": "code_interpreter"} or {"type": "file_search"}.
+type AttachmentTool struct {
+	Type MessageToolType `json:"type"`
+}
+
 type Attachment struct {
-	FileID string `json:"file_id"`
-	Tools  []struct {
-		Type MessageToolType `json:"type"`
-	}
+	FileID string           `json:"file_id"`
+	Tools  []AttachmentTool `json:"tools,omitempty"`
 }
 
 type Message struct {
@@ -67,25 +73,135 @@ type MessagesList struct {
 	httpHeader
 }
 
+// MessageContentType identifies the shape of a MessageContent /
+// MessageContentPart entry.
+type MessageContentType string
+
+const (
+	MessageContentTypeText      MessageContentType = "text"
+	MessageContentTypeImageFile MessageContentType = "image_file"
+	MessageContentTypeImageURL  MessageContentType = "image_url"
+)
+
 type MessageContent struct {
-	Type      string       `json:"type"`
-	Text      *MessageText `json:"text,omitempty"`
-	ImageFile *ImageFile   `json:"image_file,omitempty"`
+	Type      MessageContentType `json:"type"`
+	Text      *MessageText       `json:"text,omitempty"`
+	ImageFile *ImageFile         `json:"image_file,omitempty"`
+	ImageURL  *ImageURL          `json:"image_url,omitempty"`
 }
 type MessageText struct {
 	Value       string `json:"value"`
 	Annotations []any  `json:"annotations"`
 }
 
+// MessageDeltaEvent is the payload of a thread.message.delta event: a
+// partial Message carrying only the content that changed since the
+// previous event for the same message ID.
+type MessageDeltaEvent struct {
+	ID     string       `json:"id"`
+	Object string       `json:"object"`
+	Delta  MessageDelta `json:"delta"`
+}
+
+type MessageDelta struct {
+	Role    string                `json:"role,omitempty"`
+	Content []MessageContentDelta `json:"content,omitempty"`
+}
+
+// MessageContentDelta is the delta variant of MessageContent. Index
+// identifies which content part of the message the delta applies to, so
+// callers can accumulate fragments in place as they arrive.
+type MessageContentDelta struct {
+	Index     int                `json:"index"`
+	Type      MessageContentType `json:"type"`
+	Text      *MessageTextDelta  `json:"text,omitempty"`
+	ImageFile *ImageFile         `json:"image_file,omitempty"`
+	ImageURL  *ImageURL          `json:"image_url,omitempty"`
+}
+
+// MessageTextDelta is the delta variant of MessageText. Value holds only
+// the newly received fragment of text, not the accumulated value.
+type MessageTextDelta struct {
+	Value       *string `json:"value,omitempty"`
+	Annotations []any   `json:"annotations,omitempty"`
+}
+
 type ImageFile struct {
 	FileID string `json:"file_id"`
 }
 
+// ImageURL is the image_url content part, letting a message reference an
+// externally hosted image instead of an uploaded file.
+type ImageURL struct {
+	URL    string `json:"url"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// MessageContentPart is one element of the content-parts array set via
+// MessageRequest.MultiContent (sent as "content") for multimodal input.
+type MessageContentPart struct {
+	Type      MessageContentType `json:"type"`
+	Text      string             `json:"text,omitempty"`
+	ImageURL  *ImageURL          `json:"image_url,omitempty"`
+	ImageFile *ImageFile         `json:"image_file,omitempty"`
+}
+
+// ErrMessageContentFieldsMisused is returned by MessageRequest's MarshalJSON
+// when both Content and MultiContent are set; only one represents the
+// message body.
+var ErrMessageContentFieldsMisused = errors.New("can't use both Content and MultiContent properties simultaneously")
+
+// MessageRequest is the body of CreateMessage and CreateMessageStream.
+// Content holds plain-text input; to send multimodal content parts (e.g. an
+// image_url alongside text), set MultiContent instead and leave Content
+// empty.
 type MessageRequest struct {
-	Role     string         `json:"role"`
-	Content  string         `json:"content"`
-	FileIds  []string       `json:"file_ids,omitempty"` //nolint:revive // backwards-compatibility
-	Metadata map[string]any `json:"metadata,omitempty"`
+	Role         string               `json:"role"`
+	Content      string               `json:"content,omitempty"`
+	MultiContent []MessageContentPart `json:"-"`
+	FileIds      []string             `json:"file_ids,omitempty"` //nolint:revive // backwards-compatibility
+	Attachments  []Attachment         `json:"attachments,omitempty"`
+	Metadata     map[string]any       `json:"metadata,omitempty"`
+}
+
+// MarshalJSON marshals MultiContent as the "content" array when set,
+// otherwise falls back to the plain-text Content field.
+func (m MessageRequest) MarshalJSON() ([]byte, error) {
+	if m.Content != "" && len(m.MultiContent) > 0 {
+		return nil, ErrMessageContentFieldsMisused
+	}
+
+	if len(m.MultiContent) > 0 {
+		msg := struct {
+			Role        string               `json:"role"`
+			Content     []MessageContentPart `json:"content"`
+			FileIds     []string             `json:"file_ids,omitempty"` //nolint:revive // backwards-compatibility
+			Attachments []Attachment         `json:"attachments,omitempty"`
+			Metadata    map[string]any       `json:"metadata,omitempty"`
+		}{
+			Role:        m.Role,
+			Content:     m.MultiContent,
+			FileIds:     m.FileIds,
+			Attachments: m.Attachments,
+			Metadata:    m.Metadata,
+		}
+		return json.Marshal(msg)
+	}
+
+	msg := struct {
+		Role        string         `json:"role"`
+		Content     string         `json:"content"`
+		FileIds     []string       `json:"file_ids,omitempty"` //nolint:revive // backwards-compatibility
+		Attachments []Attachment   `json:"attachments,omitempty"`
+		Metadata    map[string]any `json:"metadata,omitempty"`
+	}{
+		Role:        m.Role,
+		Content:     m.Content,
+		FileIds:     m.FileIds,
+		Attachments: m.Attachments,
+		Metadata:    m.Metadata,
+	}
+	return json.Marshal(msg)
 }
 
 type MessageFile struct {
@@ -104,41 +220,63 @@ type MessageFilesList struct {
 }
 
 // CreateMessage creates a new message.
-func (c *Client) CreateMessage(ctx context.Context, threadID string, request MessageRequest) (msg Message, err error) {
+func (c *Client) CreateMessage(
+	ctx context.Context,
+	threadID string,
+	request MessageRequest,
+	opts ...MessageRequestOption,
+) (msg Message, err error) {
+	ctx, cancel, translate := withOperatorDeadline(ctx, opts)
+	defer cancel()
+
 	urlSuffix := fmt.Sprintf("/threads/%s/%s", threadID, messagesSuffix)
 	req, err := c.newRequest(ctx, http.MethodPost, c.fullURL(urlSuffix), withBody(request),
 		withBetaAssistantVersion(c.config.AssistantVersion))
 	if err != nil {
+		err = translate(err)
 		return
 	}
 
-	err = c.sendRequest(req, &msg)
+	err = translate(c.sendRequest(req, &msg))
 	return
 }
 
-// ListMessage fetches all messages in the thread.
-func (c *Client) ListMessage(ctx context.Context, threadID string,
-	limit *int,
-	order *string,
-	after *string,
-	before *string,
-	runID *string,
+// ListMessagesOptions holds the optional query parameters accepted by
+// ListMessage, replacing the previous pile of individual pointer
+// parameters so new cursor fields don't require another signature change.
+type ListMessagesOptions struct {
+	Limit  *int
+	Order  *string
+	After  *string
+	Before *string
+	RunID  *string
+}
+
+// ListMessage fetches a single page of messages in the thread.
+func (c *Client) ListMessage(
+	ctx context.Context,
+	threadID string,
+	query ListMessagesOptions,
+	opts ...MessageRequestOption,
 ) (messages MessagesList, err error) {
+	ctx, cancel, translate := withOperatorDeadline(ctx, opts)
+	defer cancel()
+
 	urlValues := url.Values{}
-	if limit != nil {
-		urlValues.Add("limit", fmt.Sprintf("%d", *limit))
+	if query.Limit != nil {
+		urlValues.Add("limit", fmt.Sprintf("%d", *query.Limit))
 	}
-	if order != nil {
-		urlValues.Add("order", *order)
+	if query.Order != nil {
+		urlValues.Add("order", *query.Order)
 	}
-	if after != nil {
-		urlValues.Add("after", *after)
+	if query.After != nil {
+		urlValues.Add("after", *query.After)
 	}
-	if before != nil {
-		urlValues.Add("before", *before)
+	if query.Before != nil {
+		urlValues.Add("before", *query.Before)
 	}
-	if runID != nil {
-		urlValues.Add("run_id", *runID)
+	if query.RunID != nil {
+		urlValues.Add("run_id", *query.RunID)
 	}
 	encodedValues := ""
 	if len(urlValues) > 0 {
@@ -149,13 +287,138 @@ func (c *Client) ListMessage(ctx context.Context, threadID string,
 	req, err := c.newRequest(ctx, http.MethodGet, c.fullURL(urlSuffix),
 		withBetaAssistantVersion(c.config.AssistantVersion))
 	if err != nil {
+		err = translate(err)
 		return
 	}
 
-	err = c.sendRequest(req, &messages)
+	err = translate(c.sendRequest(req, &messages))
 	return
 }
 
+// MessagesIterator transparently pages through ListMessage results using
+// the LastID/HasMore cursor each page returns, so callers don't have to
+// thread After/Before by hand.
+type MessagesIterator struct {
+	ctx      context.Context
+	client   *Client
+	threadID string
+	opts     ListMessagesOptions
+
+	// pagingBefore records which cursor direction the caller started
+	// paging in, so later pages advance Before (not After) when the
+	// caller asked to page backward from a pivot message via opts.Before.
+	pagingBefore bool
+
+	page    []Message
+	pageIdx int
+	cur     Message
+
+	started bool
+	hasMore bool
+
+	err error
+}
+
+// MessagesIterator returns an iterator over all messages in the thread
+// matching opts, fetching additional pages from the API on demand.
+func (c *Client) MessagesIterator(ctx context.Context, threadID string, opts ListMessagesOptions) *MessagesIterator {
+	return &MessagesIterator{
+		ctx:          ctx,
+		client:       c,
+		threadID:     threadID,
+		opts:         opts,
+		pagingBefore: opts.After == nil && opts.Before != nil,
+	}
+}
+
+// nextListMessagesOptions advances opts' cursor to fetch the page after
+// resp. It drives Before from resp.FirstID when the caller is paging
+// backward (pagingBefore), and After from resp.LastID otherwise, clearing
+// the other cursor field so a stale value from the first page is never
+// combined with the newly fetched one.
+func nextListMessagesOptions(opts ListMessagesOptions, pagingBefore bool, resp MessagesList) ListMessagesOptions {
+	if pagingBefore {
+		opts.Before = resp.FirstID
+		opts.After = nil
+		return opts
+	}
+	opts.After = resp.LastID
+	opts.Before = nil
+	return opts
+}
+
+// Next fetches the next message, returning false once there are no more
+// messages or an error occurred (check Err in that case). It honors ctx
+// cancellation between page fetches.
+func (it *MessagesIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.pageIdx < len(it.page) {
+		it.cur = it.page[it.pageIdx]
+		it.pageIdx++
+		return true
+	}
+
+	if it.started && !it.hasMore {
+		return false
+	}
+
+	select {
+	case <-it.ctx.Done():
+		it.err = it.ctx.Err()
+		return false
+	default:
+	}
+
+	resp, err := it.client.ListMessage(it.ctx, it.threadID, it.opts)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.started = true
+	it.page = resp.Messages
+	it.pageIdx = 0
+	it.hasMore = resp.HasMore
+	it.opts = nextListMessagesOptions(it.opts, it.pagingBefore, resp)
+
+	if len(it.page) == 0 {
+		return false
+	}
+
+	it.cur = it.page[0]
+	it.pageIdx = 1
+	return true
+}
+
+// Message returns the message most recently advanced to by Next.
+func (it *MessagesIterator) Message() Message {
+	return it.cur
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *MessagesIterator) Err() error {
+	return it.err
+}
+
+// Collect drains the iterator into a slice using ctx, stopping once limit
+// messages have been collected. A non-positive limit collects every
+// remaining message.
+func (it *MessagesIterator) Collect(ctx context.Context, limit int) ([]Message, error) {
+	it.ctx = ctx
+
+	var out []Message
+	for it.Next() {
+		out = append(out, it.Message())
+		if limit > 0 && len(out) >= limit {
+			break
+		}
+	}
+	return out, it.Err()
+}
+
 // RetrieveMessage retrieves a Message.
 func (c *Client) RetrieveMessage(
 	ctx context.Context,
@@ -172,15 +435,39 @@ func (c *Client) RetrieveMessage(
 	return
 }
 
-// ModifyMessage modifies a message.
+// ModifyMessageRequest is the body accepted by ModifyMessageWithRequest. It
+// is kept separate from MessageRequest since only a subset of fields are
+// mutable once a message has been created.
+type ModifyMessageRequest struct {
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// ModifyMessage modifies a message's metadata.
+//
+// Deprecated: use ModifyMessageWithRequest, which accepts the full
+// ModifyMessageRequest body instead of a bare metadata map.
 func (c *Client) ModifyMessage(
 	ctx context.Context,
 	threadID, messageID string,
 	metadata map[string]string,
+) (msg Message, err error) {
+	metadataAny := make(map[string]any, len(metadata))
+	for k, v := range metadata {
+		metadataAny[k] = v
+	}
+
+	return c.ModifyMessageWithRequest(ctx, threadID, messageID, ModifyMessageRequest{Metadata: metadataAny})
+}
+
+// ModifyMessageWithRequest modifies a message.
+func (c *Client) ModifyMessageWithRequest(
+	ctx context.Context,
+	threadID, messageID string,
+	request ModifyMessageRequest,
 ) (msg Message, err error) {
 	urlSuffix := fmt.Sprintf("/threads/%s/%s/%s", threadID, messagesSuffix, messageID)
 	req, err := c.newRequest(ctx, http.MethodPost, c.fullURL(urlSuffix),
-		withBody(map[string]any{"metadata": metadata}), withBetaAssistantVersion(c.config.AssistantVersion))
+		withBody(request), withBetaAssistantVersion(c.config.AssistantVersion))
 	if err != nil {
 		return
 	}
@@ -189,6 +476,29 @@ func (c *Client) ModifyMessage(
 	return
 }
 
+// MessageDeletionStatus is the response to DeleteMessage.
+type MessageDeletionStatus struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Deleted bool   `json:"deleted"`
+}
+
+// DeleteMessage deletes a message.
+func (c *Client) DeleteMessage(
+	ctx context.Context,
+	threadID, messageID string,
+) (status MessageDeletionStatus, err error) {
+	urlSuffix := fmt.Sprintf("/threads/%s/%s/%s", threadID, messagesSuffix, messageID)
+	req, err := c.newRequest(ctx, http.MethodDelete, c.fullURL(urlSuffix),
+		withBetaAssistantVersion(c.config.AssistantVersion))
+	if err != nil {
+		return
+	}
+
+	err = c.sendRequest(req, &status)
+	return
+}
+
 // RetrieveMessageFile fetches a message file.
 func (c *Client) RetrieveMessageFile(
 	ctx context.Context,
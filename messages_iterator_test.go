@@ -0,0 +1,42 @@
+package openai
+
+import "testing"
+
+func TestNextListMessagesOptionsForward(t *testing.T) {
+	after := "msg_1"
+	before := "msg_pivot"
+	opts := ListMessagesOptions{After: &after, Before: &before}
+
+	lastID := "msg_10"
+	resp := MessagesList{FirstID: strPtr("msg_2"), LastID: &lastID}
+
+	got := nextListMessagesOptions(opts, false, resp)
+
+	if got.Before != nil {
+		t.Fatalf("Before = %v, want nil once forward paging has a fresh After cursor", got.Before)
+	}
+	if got.After == nil || *got.After != lastID {
+		t.Fatalf("After = %v, want %q", got.After, lastID)
+	}
+}
+
+func TestNextListMessagesOptionsBackward(t *testing.T) {
+	before := "msg_pivot"
+	opts := ListMessagesOptions{Before: &before}
+
+	firstID := "msg_2"
+	resp := MessagesList{FirstID: &firstID, LastID: strPtr("msg_10")}
+
+	got := nextListMessagesOptions(opts, true, resp)
+
+	if got.After != nil {
+		t.Fatalf("After = %v, want nil when paging backward via Before", got.After)
+	}
+	if got.Before == nil || *got.Before != firstID {
+		t.Fatalf("Before = %v, want %q", got.Before, firstID)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,341 @@
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	messageStreamEventPrefix = []byte("event: ")
+	messageStreamDataPrefix  = []byte("data: ")
+	messageStreamDone        = []byte("[DONE]")
+)
+
+// messageStreamEmptyMessagesLimit bounds how many consecutive empty/unknown
+// lines Recv will skip before giving up, so a misbehaving connection can't
+// spin the caller forever.
+const messageStreamEmptyMessagesLimit uint = 300
+
+// MessageStreamEventType identifies the kind of server-sent event emitted
+// while streaming a run's messages, matching the `event` line OpenAI sends
+// alongside each `data:` payload.
+type MessageStreamEventType string
+
+const (
+	MessageStreamEventTypeMessageCreated    MessageStreamEventType = "thread.message.created"
+	MessageStreamEventTypeMessageDelta      MessageStreamEventType = "thread.message.delta"
+	MessageStreamEventTypeMessageCompleted  MessageStreamEventType = "thread.message.completed"
+	MessageStreamEventTypeMessageIncomplete MessageStreamEventType = "thread.message.incomplete"
+	MessageStreamEventTypeRunStepCreated    MessageStreamEventType = "thread.run.step.created"
+	MessageStreamEventTypeRunStepDelta      MessageStreamEventType = "thread.run.step.delta"
+	MessageStreamEventTypeRunStepCompleted  MessageStreamEventType = "thread.run.step.completed"
+	MessageStreamEventTypeDone              MessageStreamEventType = "done"
+)
+
+// MessageStreamEvent is a single decoded server-sent event from a message
+// stream opened by CreateMessageStream or StreamRunMessages. For the
+// message and run step event types listed above, exactly one of Message,
+// MessageDelta or RunStep is populated, selected by Event; for any other
+// event name (e.g. thread.run.created) only Event is set.
+type MessageStreamEvent struct {
+	Event MessageStreamEventType `json:"event"`
+
+	Message      *Message           `json:"-"`
+	MessageDelta *MessageDeltaEvent `json:"-"`
+	RunStep      *RunStep           `json:"-"`
+}
+
+// MessageStream streams the events produced by an Assistants v2 run as it
+// creates and fills in a message, so callers can render partial output
+// instead of polling ListMessage. Callers must call Close once done reading.
+type MessageStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// translate turns a context.DeadlineExceeded observed on ctx into
+	// ErrDeadlineExceeded when it was a WithDeadline option, rather than the
+	// caller's own ctx, that caused it.
+	translate func(error) error
+
+	reader   *bufio.Reader
+	response *http.Response
+
+	isFinished bool
+
+	deadlineMu    sync.Mutex
+	deadlineTimer *time.Timer
+	deadlineHit   atomic.Bool
+
+	httpHeader
+}
+
+// runStreamRequest is the body of the POST that starts a streaming run. It
+// intentionally only carries the fields CreateMessageStream/
+// StreamRunMessages need; the full run-creation surface lives alongside
+// CreateRun.
+type runStreamRequest struct {
+	AssistantID string `json:"assistant_id"`
+	Stream      bool   `json:"stream"`
+}
+
+// CreateMessageStream adds a message to the thread, then starts a streaming
+// run for assistantID and returns the thread.message.created/delta/completed
+// (and related run step) events as the run produces them. Only run creation
+// streams in the Assistants v2 API — there is no SSE endpoint on the
+// messages resource itself — so this issues the ordinary (non-streaming)
+// CreateMessage followed by a streaming run create. The stream is tied to
+// ctx: cancelling ctx aborts the underlying HTTP request and causes Recv to
+// return ctx.Err(). Passing WithDeadline additionally bounds the whole call,
+// from adding the message through every Recv on the returned stream; use
+// MessageStream.SetReadDeadline for a per-event idle timeout once the
+// stream is open.
+func (c *Client) CreateMessageStream(
+	ctx context.Context,
+	threadID, assistantID string,
+	request MessageRequest,
+	opts ...MessageRequestOption,
+) (stream *MessageStream, err error) {
+	ctx, cancel, translate := withOperatorDeadline(ctx, opts)
+
+	if _, createErr := c.CreateMessage(ctx, threadID, request); createErr != nil {
+		cancel()
+		err = translate(createErr)
+		return
+	}
+
+	return c.startRunStream(ctx, cancel, translate, threadID, assistantID)
+}
+
+// StreamRunMessages starts a streaming run for assistantID on threadID and
+// returns the same MessageStreamEvent values as CreateMessageStream. Use
+// this when the thread's messages were already added separately (e.g. via
+// CreateMessage) and the caller only needs to start and stream the run
+// itself — the Assistants v2 API has no way to attach to the stream of a
+// run that's already running, so this always starts a new one.
+func (c *Client) StreamRunMessages(
+	ctx context.Context,
+	threadID, assistantID string,
+	opts ...MessageRequestOption,
+) (stream *MessageStream, err error) {
+	ctx, cancel, translate := withOperatorDeadline(ctx, opts)
+	return c.startRunStream(ctx, cancel, translate, threadID, assistantID)
+}
+
+func (c *Client) startRunStream(
+	ctx context.Context,
+	deadlineCancel context.CancelFunc,
+	translate func(error) error,
+	threadID, assistantID string,
+) (stream *MessageStream, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	urlSuffix := fmt.Sprintf("/threads/%s/runs", threadID)
+	req, err := c.newRequest(ctx, http.MethodPost, c.fullURL(urlSuffix),
+		withBody(runStreamRequest{AssistantID: assistantID, Stream: true}),
+		withBetaAssistantVersion(c.config.AssistantVersion))
+	if err != nil {
+		cancel()
+		deadlineCancel()
+		err = translate(err)
+		return
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.config.HTTPClient.Do(req)
+	if err != nil {
+		cancel()
+		deadlineCancel()
+		err = translate(err)
+		return
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		cancel()
+		deadlineCancel()
+
+		var errRes ErrorResponse
+		if decodeErr := json.NewDecoder(resp.Body).Decode(&errRes); decodeErr != nil || errRes.Error == nil {
+			err = fmt.Errorf("error, reading response: %w", decodeErr)
+			return
+		}
+		err = errRes.Error
+		return
+	}
+
+	stream = &MessageStream{
+		ctx: ctx,
+		cancel: func() {
+			cancel()
+			deadlineCancel()
+		},
+		translate:  translate,
+		reader:     bufio.NewReader(resp.Body),
+		response:   resp,
+		httpHeader: httpHeader(resp.Header),
+	}
+	return
+}
+
+// SetReadDeadline arms an operator-configured deadline, independent of the
+// stream's context, after which an in-flight or future Recv call aborts
+// with ErrDeadlineExceeded. Calling it again before the deadline fires
+// pushes it back — e.g. a caller implementing "abort if no event arrives
+// for 30s" resets it after every successful Recv — but unlike
+// net.Conn.SetReadDeadline, firing is terminal: it tears down the stream's
+// underlying connection, so there is nothing left for a SetReadDeadline
+// call made afterwards to revive. A zero Time clears a pending deadline.
+func (stream *MessageStream) SetReadDeadline(t time.Time) {
+	stream.deadlineMu.Lock()
+	defer stream.deadlineMu.Unlock()
+
+	if stream.deadlineTimer != nil {
+		stream.deadlineTimer.Stop()
+	}
+	if stream.deadlineHit.Load() {
+		// firing is terminal: keep reporting ErrDeadlineExceeded.
+		return
+	}
+
+	if t.IsZero() {
+		stream.deadlineTimer = nil
+		return
+	}
+
+	if dur := time.Until(t); dur > 0 {
+		stream.deadlineTimer = time.AfterFunc(dur, stream.expireReadDeadline)
+		return
+	}
+	stream.expireReadDeadline()
+}
+
+func (stream *MessageStream) expireReadDeadline() {
+	stream.deadlineHit.Store(true)
+	stream.cancel()
+}
+
+// Recv blocks until the next event is available, the stream ends (io.EOF),
+// or ctx is cancelled. It is not safe to call Recv concurrently.
+func (stream *MessageStream) Recv() (event MessageStreamEvent, err error) {
+	if stream.isFinished {
+		err = io.EOF
+		return
+	}
+
+	var (
+		eventName          string
+		emptyMessagesCount uint
+	)
+
+	for {
+		select {
+		case <-stream.ctx.Done():
+			stream.isFinished = true
+			err = stream.deadlineErr(stream.ctx.Err())
+			return
+		default:
+		}
+
+		rawLine, readErr := stream.reader.ReadBytes('\n')
+		if readErr != nil {
+			stream.isFinished = true
+			err = stream.deadlineErr(readErr)
+			return
+		}
+
+		line := bytes.TrimSpace(rawLine)
+		switch {
+		case bytes.HasPrefix(line, messageStreamDataPrefix):
+			data := bytes.TrimPrefix(line, messageStreamDataPrefix)
+			if eventName == string(MessageStreamEventTypeDone) || bytes.Equal(data, messageStreamDone) {
+				stream.isFinished = true
+				err = io.EOF
+				return
+			}
+			return decodeMessageStreamEvent(MessageStreamEventType(eventName), data)
+		case bytes.HasPrefix(line, messageStreamEventPrefix):
+			eventName = string(bytes.TrimPrefix(line, messageStreamEventPrefix))
+			fallthrough
+		default:
+			// Every line that isn't a data: payload — blank lines, SSE
+			// comment/keep-alive lines (": ping"), a lone event: line, or
+			// anything else unrecognized — counts against the limit, so a
+			// misbehaving connection that never sends a data: payload can't
+			// spin Recv forever.
+			emptyMessagesCount++
+			if emptyMessagesCount > messageStreamEmptyMessagesLimit {
+				stream.isFinished = true
+				err = ErrTooManyEmptyStreamMessages
+				return
+			}
+			continue
+		}
+	}
+}
+
+func decodeMessageStreamEvent(eventName MessageStreamEventType, data []byte) (event MessageStreamEvent, err error) {
+	event.Event = eventName
+
+	switch eventName {
+	case MessageStreamEventTypeMessageCreated,
+		MessageStreamEventTypeMessageCompleted,
+		MessageStreamEventTypeMessageIncomplete:
+		var msg Message
+		if err = json.Unmarshal(data, &msg); err != nil {
+			return
+		}
+		event.Message = &msg
+	case MessageStreamEventTypeMessageDelta:
+		var delta MessageDeltaEvent
+		if err = json.Unmarshal(data, &delta); err != nil {
+			return
+		}
+		event.MessageDelta = &delta
+	case MessageStreamEventTypeRunStepCreated,
+		MessageStreamEventTypeRunStepDelta,
+		MessageStreamEventTypeRunStepCompleted:
+		var step RunStep
+		if err = json.Unmarshal(data, &step); err != nil {
+			return
+		}
+		event.RunStep = &step
+	case MessageStreamEventTypeDone:
+		// handled by the caller before reaching here.
+	}
+	return
+}
+
+// Close cancels the stream's context and releases the underlying HTTP
+// connection. It is safe to call Close more than once.
+func (stream *MessageStream) Close() error {
+	stream.deadlineMu.Lock()
+	if stream.deadlineTimer != nil {
+		stream.deadlineTimer.Stop()
+	}
+	stream.deadlineMu.Unlock()
+
+	stream.cancel()
+	return stream.response.Body.Close()
+}
+
+// deadlineErr reports ErrDeadlineExceeded instead of err when err was
+// caused by SetReadDeadline firing, or by the WithDeadline option passed to
+// CreateMessageStream/StreamRunMessages firing, rather than the caller's
+// own ctx.
+func (stream *MessageStream) deadlineErr(err error) error {
+	if stream.deadlineHit.Load() {
+		return ErrDeadlineExceeded
+	}
+	if stream.translate != nil {
+		return stream.translate(err)
+	}
+	return err
+}
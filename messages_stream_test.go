@@ -0,0 +1,103 @@
+package openai
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDecodeMessageStreamEvent(t *testing.T) {
+	msg, err := decodeMessageStreamEvent(MessageStreamEventTypeMessageCreated, []byte(`{"id":"msg_1","object":"thread.message"}`))
+	if err != nil {
+		t.Fatalf("decode message_created: %v", err)
+	}
+	if msg.Message == nil || msg.Message.ID != "msg_1" {
+		t.Fatalf("got Message = %+v, want ID msg_1", msg.Message)
+	}
+
+	delta, err := decodeMessageStreamEvent(
+		MessageStreamEventTypeMessageDelta,
+		[]byte(`{"id":"msg_1","object":"thread.message.delta","delta":{"content":[{"index":0,"type":"text","text":{"value":"hi"}}]}}`),
+	)
+	if err != nil {
+		t.Fatalf("decode message_delta: %v", err)
+	}
+	if delta.MessageDelta == nil || len(delta.MessageDelta.Delta.Content) != 1 ||
+		delta.MessageDelta.Delta.Content[0].Text == nil || delta.MessageDelta.Delta.Content[0].Text.Value == nil ||
+		*delta.MessageDelta.Delta.Content[0].Text.Value != "hi" {
+		t.Fatalf("got MessageDelta = %+v, want content[0].text.value \"hi\"", delta.MessageDelta)
+	}
+
+	step, err := decodeMessageStreamEvent(MessageStreamEventTypeRunStepCreated, []byte(`{"id":"step_1"}`))
+	if err != nil {
+		t.Fatalf("decode run_step_created: %v", err)
+	}
+	if step.RunStep == nil || step.RunStep.ID != "step_1" {
+		t.Fatalf("got RunStep = %+v, want ID step_1", step.RunStep)
+	}
+}
+
+// newTestMessageStream builds a MessageStream directly over an in-memory SSE
+// body, bypassing HTTP entirely, so the line-framing and event decoding in
+// Recv can be exercised without a real server.
+func newTestMessageStream(sse string) *MessageStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &MessageStream{
+		ctx:        ctx,
+		cancel:     cancel,
+		reader:     bufio.NewReader(strings.NewReader(sse)),
+		response:   &http.Response{Body: http.NoBody},
+		httpHeader: httpHeader(http.Header{}),
+	}
+}
+
+func TestMessageStreamRecvFramesEventsAndStopsAtDone(t *testing.T) {
+	sse := "" +
+		"event: thread.message.created\n" +
+		"data: {\"id\":\"msg_1\",\"object\":\"thread.message\"}\n" +
+		"\n" +
+		"event: thread.message.delta\n" +
+		"data: {\"id\":\"msg_1\",\"object\":\"thread.message.delta\",\"delta\":{\"content\":[{\"index\":0,\"type\":\"text\",\"text\":{\"value\":\"hi\"}}]}}\n" +
+		"\n" +
+		"event: done\n" +
+		"data: [DONE]\n"
+
+	stream := newTestMessageStream(sse)
+
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("first Recv: %v", err)
+	}
+	if first.Event != MessageStreamEventTypeMessageCreated || first.Message == nil || first.Message.ID != "msg_1" {
+		t.Fatalf("first event = %+v, want thread.message.created for msg_1", first)
+	}
+
+	second, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("second Recv: %v", err)
+	}
+	if second.Event != MessageStreamEventTypeMessageDelta || second.MessageDelta == nil {
+		t.Fatalf("second event = %+v, want thread.message.delta", second)
+	}
+
+	if _, err := stream.Recv(); err == nil {
+		t.Fatal("Recv after done event: want an error (io.EOF), got nil")
+	}
+}
+
+func TestMessageStreamRecvBoundsUnrecognizedLines(t *testing.T) {
+	// A keep-alive line ": ping" never followed by a data: payload isn't
+	// blank, but it must still count against
+	// messageStreamEmptyMessagesLimit or a flaky proxy emitting these
+	// forever would hang Recv with no deadline set.
+	sse := strings.Repeat(": ping\n", int(messageStreamEmptyMessagesLimit)+1)
+	stream := newTestMessageStream(sse)
+
+	_, err := stream.Recv()
+	if !errors.Is(err, ErrTooManyEmptyStreamMessages) {
+		t.Fatalf("Recv() err = %v, want ErrTooManyEmptyStreamMessages", err)
+	}
+}
@@ -0,0 +1,93 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupMessagesTestServer(t *testing.T, pattern string, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(pattern, handler)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	config := DefaultConfig("test-token")
+	config.BaseURL = ts.URL + "/v1"
+	return NewClientWithConfig(config)
+}
+
+func TestDeleteMessage(t *testing.T) {
+	client := setupMessagesTestServer(t, "/v1/threads/thread_abc123/messages/msg_abc123", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		_ = json.NewEncoder(w).Encode(MessageDeletionStatus{
+			ID:      "msg_abc123",
+			Object:  "thread.message.deleted",
+			Deleted: true,
+		})
+	})
+
+	status, err := client.DeleteMessage(context.Background(), "thread_abc123", "msg_abc123")
+	if err != nil {
+		t.Fatalf("DeleteMessage: %v", err)
+	}
+	if !status.Deleted || status.ID != "msg_abc123" {
+		t.Fatalf("status = %+v, want a deleted msg_abc123", status)
+	}
+}
+
+func TestModifyMessageWithRequest(t *testing.T) {
+	client := setupMessagesTestServer(t, "/v1/threads/thread_abc123/messages/msg_abc123", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+
+		var body ModifyMessageRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if body.Metadata["key"] != "value" {
+			t.Fatalf("body.Metadata = %v, want key=value", body.Metadata)
+		}
+
+		_ = json.NewEncoder(w).Encode(Message{ID: "msg_abc123", Metadata: body.Metadata})
+	})
+
+	msg, err := client.ModifyMessageWithRequest(context.Background(), "thread_abc123", "msg_abc123",
+		ModifyMessageRequest{Metadata: map[string]any{"key": "value"}})
+	if err != nil {
+		t.Fatalf("ModifyMessageWithRequest: %v", err)
+	}
+	if msg.Metadata["key"] != "value" {
+		t.Fatalf("msg.Metadata = %v, want key=value", msg.Metadata)
+	}
+}
+
+func TestModifyMessageWrapsMetadataIntoModifyMessageRequest(t *testing.T) {
+	client := setupMessagesTestServer(t, "/v1/threads/thread_abc123/messages/msg_abc123", func(w http.ResponseWriter, r *http.Request) {
+		var body ModifyMessageRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if body.Metadata["key"] != "value" {
+			t.Fatalf("body.Metadata = %v, want the string map translated to map[string]any{\"key\":\"value\"}", body.Metadata)
+		}
+
+		_ = json.NewEncoder(w).Encode(Message{ID: "msg_abc123", Metadata: body.Metadata})
+	})
+
+	//nolint:staticcheck // exercising the deprecated wrapper itself.
+	msg, err := client.ModifyMessage(context.Background(), "thread_abc123", "msg_abc123", map[string]string{"key": "value"})
+	if err != nil {
+		t.Fatalf("ModifyMessage: %v", err)
+	}
+	if msg.Metadata["key"] != "value" {
+		t.Fatalf("msg.Metadata = %v, want key=value", msg.Metadata)
+	}
+}